@@ -0,0 +1,41 @@
+// Package client abstracts dialing a gRPC connection to the octavius daemon
+// so cobra commands can swap in a mock instead of opening a real network
+// connection in tests.
+package client
+
+import (
+	"octavius/internal/pkg/protofiles"
+
+	"google.golang.org/grpc"
+)
+
+// Client builds the typed gRPC stubs the CLI talks to.
+type Client interface {
+	GetExecutionServiceClient(conn *grpc.ClientConn) protofiles.ExecutionServiceClient
+	GetSchedulerServiceClient(conn *grpc.ClientConn) protofiles.SchedulerServiceClient
+	GetMetadataServiceClient(conn *grpc.ClientConn) protofiles.MetadataServiceClient
+	GetLogServiceClient(conn *grpc.ClientConn) protofiles.LogServiceClient
+}
+
+// GrpcClient is the production Client used by the CLI commands.
+type GrpcClient struct{}
+
+// GetExecutionServiceClient returns an ExecutionService client bound to conn.
+func (c *GrpcClient) GetExecutionServiceClient(conn *grpc.ClientConn) protofiles.ExecutionServiceClient {
+	return protofiles.NewExecutionServiceClient(conn)
+}
+
+// GetSchedulerServiceClient returns a SchedulerService client bound to conn.
+func (c *GrpcClient) GetSchedulerServiceClient(conn *grpc.ClientConn) protofiles.SchedulerServiceClient {
+	return protofiles.NewSchedulerServiceClient(conn)
+}
+
+// GetMetadataServiceClient returns a MetadataService client bound to conn.
+func (c *GrpcClient) GetMetadataServiceClient(conn *grpc.ClientConn) protofiles.MetadataServiceClient {
+	return protofiles.NewMetadataServiceClient(conn)
+}
+
+// GetLogServiceClient returns a LogService client bound to conn.
+func (c *GrpcClient) GetLogServiceClient(conn *grpc.ClientConn) protofiles.LogServiceClient {
+	return protofiles.NewLogServiceClient(conn)
+}
@@ -0,0 +1,53 @@
+// Package describe implements the "describe" cobra command.
+package describe
+
+import (
+	"fmt"
+	"octavius/internal/cli/client"
+	"octavius/internal/cli/daemon"
+	"octavius/internal/pkg/auth"
+	"octavius/internal/pkg/log"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCmd creates a command that prints a single job's metadata and argument schema.
+func NewCmd(octaviusDaemon daemon.Client) *cobra.Command {
+	return &cobra.Command{
+		Use:     "describe",
+		Short:   "Describe a job registered with the server",
+		Long:    "This command fetches and pretty-prints the metadata of a job that is already created in server",
+		Example: fmt.Sprintf("octavius describe <job-name>"),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			jobName := args[0]
+
+			ctx, err := auth.OutgoingContext()
+			if err != nil {
+				log.Error(err, "error in loading caller identity from ~/.octavius/auth.yaml")
+				return
+			}
+
+			metadata, err := octaviusDaemon.DescribeJob(ctx, jobName, &client.GrpcClient{})
+			if err != nil {
+				log.Error(err, "error in describing job")
+				return
+			}
+
+			log.Info(fmt.Sprintf("Name: %s", metadata.Name))
+			log.Info(fmt.Sprintf("Author: %s", metadata.Author))
+			log.Info(fmt.Sprintf("Image: %s", metadata.ImageName))
+			log.Info(fmt.Sprintf("Description: %s", metadata.Description))
+
+			if len(metadata.Args) == 0 {
+				log.Info("Args: none")
+				return
+			}
+			log.Info("Args:")
+			for _, arg := range metadata.Args {
+				log.Info(fmt.Sprintf("  %s\ttype=%s\trequired=%t\tdefault=%q\tallowed=%v\tregex=%q",
+					arg.Name, arg.Type, arg.Required, arg.Default, arg.AllowedValues, arg.Regex))
+			}
+		},
+	}
+}
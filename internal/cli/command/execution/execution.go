@@ -3,16 +3,20 @@ package execution
 import (
 	"fmt"
 	"octavius/internal/cli/client"
+	"octavius/internal/cli/command/logs"
 	"octavius/internal/cli/daemon"
+	"octavius/internal/pkg/argspec"
+	"octavius/internal/pkg/auth"
 	"octavius/internal/pkg/log"
-	"strings"
 
 	"github.com/spf13/cobra"
 )
 
 // NewCmd create a command for execution
 func NewCmd(octaviusDaemon daemon.Client) *cobra.Command {
-	return &cobra.Command{
+	var follow bool
+
+	cmd := &cobra.Command{
 		Use:     "execute",
 		Short:   "Execute the existing job",
 		Long:    "This command helps to execute the job which is already created in server",
@@ -20,19 +24,41 @@ func NewCmd(octaviusDaemon daemon.Client) *cobra.Command {
 		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			jobName := args[0]
-			jobData := map[string]string{}
+			grpcClient := &client.GrpcClient{}
+
+			ctx, err := auth.OutgoingContext()
+			if err != nil {
+				log.Error(err, "error in loading caller identity from ~/.octavius/auth.yaml")
+				return
+			}
 
-			for i := 1; i < len(args); i++ {
-				arg := strings.Split(args[i], "=")
-				jobData[arg[0]] = arg[1]
+			metadata, err := octaviusDaemon.DescribeJob(ctx, jobName, grpcClient)
+			if err != nil {
+				log.Error(err, "error in fetching job schema")
+				return
 			}
-			client := &client.GrpcClient{}
-			response, err := octaviusDaemon.ExecuteJob(jobName, jobData, client)
+
+			jobData, err := argspec.Parse(metadata.Args, args[1:])
+			if err != nil {
+				log.Error(err, "error in parsing job args")
+				return
+			}
+
+			response, err := octaviusDaemon.ExecuteJob(ctx, jobName, jobData, grpcClient)
 			if err != nil {
 				log.Error(err, "error in executing job")
 				return
 			}
 			log.Info(response.Status)
+
+			if follow {
+				if err := logs.Stream(octaviusDaemon, response.ExecutionId, true); err != nil {
+					log.Error(err, "error in streaming execution logs")
+				}
+			}
 		},
 	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "stream the job's logs once it has been accepted")
+	return cmd
 }
@@ -0,0 +1,103 @@
+// Package logs implements the "logs" cobra command.
+package logs
+
+import (
+	"fmt"
+	"octavius/internal/cli/client"
+	"octavius/internal/cli/daemon"
+	"octavius/internal/pkg/auth"
+	"octavius/internal/pkg/log"
+	"octavius/internal/pkg/protofiles"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reconnectInitialBackoff and reconnectMaxBackoff bound the delay between
+// reconnect attempts in Stream, growing by reconnectBackoffMultiplier on
+// every consecutive failure so a daemon outage doesn't busy-loop the CLI,
+// and dropping back to reconnectInitialBackoff once an attempt receives a line.
+const (
+	reconnectInitialBackoff    = 500 * time.Millisecond
+	reconnectMaxBackoff        = 30 * time.Second
+	reconnectBackoffMultiplier = 2
+)
+
+// isTransient reports whether err is worth reconnecting for, rather than a
+// permanent failure (the execution id doesn't exist, the caller isn't
+// authorized, ...) that reconnecting will only reproduce forever.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Unavailable, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+// NewCmd creates a command that streams a job execution's logs.
+func NewCmd(octaviusDaemon daemon.Client) *cobra.Command {
+	var follow bool
+
+	cmd := &cobra.Command{
+		Use:     "logs <execution-id>",
+		Short:   "Stream the logs of a job execution",
+		Long:    "This command streams the log lines produced by a job execution, replaying any lines already recorded before tailing new ones",
+		Example: fmt.Sprintf("octavius logs <execution-id> --follow"),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := Stream(octaviusDaemon, args[0], follow); err != nil {
+				log.Error(err, "error in streaming execution logs")
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "reconnect and resume from the last received line if the connection to the daemon drops")
+	return cmd
+}
+
+// Stream prints executionID's log lines in order, tailing new ones until the
+// daemon ends the stream because the execution has finished. When follow is
+// true, it keeps reconnecting from the last sequence number it received after
+// a dropped connection, so a dropped connection to the daemon surfaces as a
+// brief gap in output rather than duplicate or missing lines. Reconnects
+// back off exponentially on repeated transient failures, and Stream gives up
+// immediately on a non-transient error (not found, not authorized, ...)
+// instead of retrying a request that can never succeed.
+func Stream(octaviusDaemon daemon.Client, executionID string, follow bool) error {
+	grpcClient := &client.GrpcClient{}
+
+	ctx, err := auth.OutgoingContext()
+	if err != nil {
+		return err
+	}
+
+	var lastSeq int64 = -1
+	backoff := reconnectInitialBackoff
+	for {
+		seqBefore := lastSeq
+		err := octaviusDaemon.StreamExecutionLogs(ctx, executionID, lastSeq+1, grpcClient, func(line *protofiles.LogLine) error {
+			log.Info(line.Line)
+			lastSeq = line.Seq
+			return nil
+		})
+		if err == nil || !follow {
+			return err
+		}
+		if !isTransient(err) {
+			return err
+		}
+		if lastSeq > seqBefore {
+			backoff = reconnectInitialBackoff
+		}
+
+		log.Warn("log stream disconnected, reconnecting from seq " + strconv.FormatInt(lastSeq+1, 10))
+		time.Sleep(backoff)
+		backoff *= reconnectBackoffMultiplier
+		if backoff > reconnectMaxBackoff {
+			backoff = reconnectMaxBackoff
+		}
+	}
+}
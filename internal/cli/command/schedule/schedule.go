@@ -0,0 +1,131 @@
+// Package schedule implements the cobra commands for managing cron-scheduled jobs.
+package schedule
+
+import (
+	"fmt"
+	"octavius/internal/cli/client"
+	"octavius/internal/cli/daemon"
+	"octavius/internal/pkg/argspec"
+	"octavius/internal/pkg/auth"
+	"octavius/internal/pkg/log"
+	"octavius/internal/pkg/protofiles"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCmd creates the "schedule" command and its list/describe/remove subcommands.
+func NewCmd(octaviusDaemon daemon.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule",
+		Short:   "Schedule a job to run on a cron cadence",
+		Long:    "This command registers a job, already created in server, to be run automatically on the given cron expression",
+		Example: `octavius schedule <job-name> "<cron-expr>" arg1=argvalue1 arg2=argvalue2`,
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			jobName := args[0]
+			cronExpr := args[1]
+			grpcClient := &client.GrpcClient{}
+
+			ctx, err := auth.OutgoingContext()
+			if err != nil {
+				log.Error(err, "error in loading caller identity from ~/.octavius/auth.yaml")
+				return
+			}
+
+			metadata, err := octaviusDaemon.DescribeJob(ctx, jobName, grpcClient)
+			if err != nil {
+				log.Error(err, "error in fetching job schema")
+				return
+			}
+
+			jobData, err := argspec.Parse(metadata.Args, args[2:])
+			if err != nil {
+				log.Error(err, "error in parsing job args")
+				return
+			}
+
+			scheduled, err := octaviusDaemon.ScheduleJob(ctx, &protofiles.Schedule{
+				JobName:  jobName,
+				CronExpr: cronExpr,
+				Args:     jobData,
+			}, grpcClient)
+			if err != nil {
+				log.Error(err, "error in scheduling job")
+				return
+			}
+			log.Info("scheduled job with id " + scheduled.Id)
+		},
+	}
+
+	cmd.AddCommand(newListCmd(octaviusDaemon))
+	cmd.AddCommand(newDescribeCmd(octaviusDaemon))
+	cmd.AddCommand(newRemoveCmd(octaviusDaemon))
+	return cmd
+}
+
+func newListCmd(octaviusDaemon daemon.Client) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List every schedule registered with the server",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, err := auth.OutgoingContext()
+			if err != nil {
+				log.Error(err, "error in loading caller identity from ~/.octavius/auth.yaml")
+				return
+			}
+
+			schedules, err := octaviusDaemon.ListSchedules(ctx, &client.GrpcClient{})
+			if err != nil {
+				log.Error(err, "error in listing schedules")
+				return
+			}
+			for _, s := range schedules.Schedules {
+				log.Info(fmt.Sprintf("%s\t%s\t%s", s.Id, s.JobName, s.CronExpr))
+			}
+		},
+	}
+}
+
+func newDescribeCmd(octaviusDaemon daemon.Client) *cobra.Command {
+	return &cobra.Command{
+		Use:   "describe <schedule-id>",
+		Short: "Describe a single schedule",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, err := auth.OutgoingContext()
+			if err != nil {
+				log.Error(err, "error in loading caller identity from ~/.octavius/auth.yaml")
+				return
+			}
+
+			s, err := octaviusDaemon.DescribeSchedule(ctx, args[0], &client.GrpcClient{})
+			if err != nil {
+				log.Error(err, "error in describing schedule")
+				return
+			}
+			log.Info(s.String())
+		},
+	}
+}
+
+func newRemoveCmd(octaviusDaemon daemon.Client) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <schedule-id>",
+		Short: "Remove a schedule",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, err := auth.OutgoingContext()
+			if err != nil {
+				log.Error(err, "error in loading caller identity from ~/.octavius/auth.yaml")
+				return
+			}
+
+			if err := octaviusDaemon.RemoveSchedule(ctx, args[0], &client.GrpcClient{}); err != nil {
+				log.Error(err, "error in removing schedule")
+				return
+			}
+			log.Info("removed schedule " + args[0])
+		},
+	}
+}
@@ -0,0 +1,140 @@
+// Package daemon dials the octavius daemon and exposes the operations the
+// CLI commands invoke on it.
+package daemon
+
+import (
+	"context"
+	"io"
+	"octavius/internal/cli/client"
+	"octavius/internal/pkg/protofiles"
+
+	"google.golang.org/grpc"
+)
+
+// Client is the set of daemon operations the CLI commands use.
+type Client interface {
+	ExecuteJob(ctx context.Context, jobName string, jobData map[string]string, grpcClient client.Client) (*protofiles.ExecutionResponse, error)
+	ScheduleJob(ctx context.Context, schedule *protofiles.Schedule, grpcClient client.Client) (*protofiles.Schedule, error)
+	ListSchedules(ctx context.Context, grpcClient client.Client) (*protofiles.ScheduleList, error)
+	DescribeSchedule(ctx context.Context, id string, grpcClient client.Client) (*protofiles.Schedule, error)
+	RemoveSchedule(ctx context.Context, id string, grpcClient client.Client) error
+	DescribeJob(ctx context.Context, jobName string, grpcClient client.Client) (*protofiles.Metadata, error)
+	// StreamExecutionLogs streams executionID's log lines from fromSeq onward,
+	// invoking onLine for each as it arrives, until the stream ends or errors.
+	StreamExecutionLogs(ctx context.Context, executionID string, fromSeq int64, grpcClient client.Client, onLine func(*protofiles.LogLine) error) error
+}
+
+type daemonClient struct {
+	serverAddress string
+}
+
+// NewClient returns a Client that dials the daemon at serverAddress on every call.
+func NewClient(serverAddress string) Client {
+	return &daemonClient{serverAddress: serverAddress}
+}
+
+// ExecuteJob sends an ExecutionRequest to the daemon. ctx is expected to
+// already carry the caller's identity, as attached by auth.NewOutgoingContext,
+// so the daemon's auth interceptor can authorize the request.
+func (d *daemonClient) ExecuteJob(ctx context.Context, jobName string, jobData map[string]string, grpcClient client.Client) (*protofiles.ExecutionResponse, error) {
+	conn, err := grpc.Dial(d.serverAddress, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	executionClient := grpcClient.GetExecutionServiceClient(conn)
+	return executionClient.Execute(ctx, &protofiles.ExecutionRequest{
+		JobName: jobName,
+		JobData: jobData,
+	})
+}
+
+// ScheduleJob registers a new cron schedule with the daemon.
+func (d *daemonClient) ScheduleJob(ctx context.Context, schedule *protofiles.Schedule, grpcClient client.Client) (*protofiles.Schedule, error) {
+	conn, err := grpc.Dial(d.serverAddress, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return grpcClient.GetSchedulerServiceClient(conn).ScheduleJob(ctx, schedule)
+}
+
+// ListSchedules lists every schedule registered with the daemon.
+func (d *daemonClient) ListSchedules(ctx context.Context, grpcClient client.Client) (*protofiles.ScheduleList, error) {
+	conn, err := grpc.Dial(d.serverAddress, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return grpcClient.GetSchedulerServiceClient(conn).ListSchedules(ctx, &protofiles.Empty{})
+}
+
+// DescribeSchedule fetches a single schedule by id.
+func (d *daemonClient) DescribeSchedule(ctx context.Context, id string, grpcClient client.Client) (*protofiles.Schedule, error) {
+	conn, err := grpc.Dial(d.serverAddress, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return grpcClient.GetSchedulerServiceClient(conn).DescribeSchedule(ctx, &protofiles.ScheduleID{Id: id})
+}
+
+// RemoveSchedule deletes a schedule by id.
+func (d *daemonClient) RemoveSchedule(ctx context.Context, id string, grpcClient client.Client) error {
+	conn, err := grpc.Dial(d.serverAddress, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = grpcClient.GetSchedulerServiceClient(conn).RemoveSchedule(ctx, &protofiles.ScheduleID{Id: id})
+	return err
+}
+
+// DescribeJob fetches a single job's metadata by name.
+func (d *daemonClient) DescribeJob(ctx context.Context, jobName string, grpcClient client.Client) (*protofiles.Metadata, error) {
+	conn, err := grpc.Dial(d.serverAddress, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return grpcClient.GetMetadataServiceClient(conn).GetMetadata(ctx, &protofiles.JobNameRequest{Name: jobName})
+}
+
+// StreamExecutionLogs opens a streaming RPC for executionID's log lines,
+// starting at fromSeq, and calls onLine for each line received. It returns
+// nil once the daemon closes the stream (the execution finished), or the
+// first error from dialing, opening the stream, receiving, or onLine.
+func (d *daemonClient) StreamExecutionLogs(ctx context.Context, executionID string, fromSeq int64, grpcClient client.Client, onLine func(*protofiles.LogLine) error) error {
+	conn, err := grpc.Dial(d.serverAddress, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := grpcClient.GetLogServiceClient(conn).StreamExecutionLogs(ctx, &protofiles.LogsRequest{
+		ExecutionId: executionID,
+		FromSeq:     fromSeq,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		line, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := onLine(line); err != nil {
+			return err
+		}
+	}
+}
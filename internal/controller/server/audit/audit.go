@@ -0,0 +1,21 @@
+// Package audit records structured entries for security- and
+// operations-relevant events so they can be queried after the fact, starting
+// with scheduled job fires.
+package audit
+
+import "octavius/internal/pkg/log"
+
+// Entry is one structured audit record.
+type Entry struct {
+	Action   string
+	JobName  string
+	Owner    string
+	Metadata map[string]string
+}
+
+// Record emits entry as a structured log line. It is kept separate from the
+// regular log calls scattered through the server so audit entries can later
+// be routed to their own sink (etcd, a SIEM, ...) without touching call sites.
+func Record(entry Entry) {
+	log.Infof("audit action=%s job=%s owner=%s metadata=%v", entry.Action, entry.JobName, entry.Owner, entry.Metadata)
+}
@@ -0,0 +1,90 @@
+// Package broadcaster fans a single stream of per-execution log lines out to
+// every concurrent subscriber watching that execution, so "octavius logs -f"
+// can be run against the same execution from more than one terminal at once.
+package broadcaster
+
+import (
+	"octavius/internal/pkg/protofiles"
+	"sync"
+)
+
+// Broadcaster fans out log lines for a set of in-flight executions to their
+// subscribers. The zero value is not usable; use New.
+type Broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *protofiles.LogLine]struct{}
+	finished    map[string]struct{}
+}
+
+// New returns an empty Broadcaster.
+func New() *Broadcaster {
+	return &Broadcaster{
+		subscribers: make(map[string]map[chan *protofiles.LogLine]struct{}),
+		finished:    make(map[string]struct{}),
+	}
+}
+
+// Subscribe registers a new subscriber for executionID's log lines. The
+// caller must call unsubscribe once it is done reading, which closes ch. If
+// executionID has already been marked Finish-ed, ch is returned already
+// closed so the caller observes end-of-stream immediately instead of
+// blocking on lines that will never arrive.
+func (b *Broadcaster) Subscribe(executionID string) (ch chan *protofiles.LogLine, unsubscribe func()) {
+	ch = make(chan *protofiles.LogLine, 64)
+
+	b.mu.Lock()
+	if _, done := b.finished[executionID]; done {
+		b.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	if b.subscribers[executionID] == nil {
+		b.subscribers[executionID] = make(map[chan *protofiles.LogLine]struct{})
+	}
+	b.subscribers[executionID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		subs := b.subscribers[executionID]
+		if _, present := subs[ch]; !present {
+			// Finish already removed and closed ch; closing again would panic.
+			return
+		}
+		delete(subs, ch)
+		if len(subs) == 0 {
+			delete(b.subscribers, executionID)
+		}
+		close(ch)
+	}
+	return ch, unsubscribe
+}
+
+// Finish marks executionID as having produced no further log lines: every
+// current subscriber's channel is closed so their stream ends, and any
+// subscriber connecting afterwards gets an already-closed channel rather
+// than waiting forever. Executors call this once a job's process exits.
+func (b *Broadcaster) Finish(executionID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.finished[executionID] = struct{}{}
+	for ch := range b.subscribers[executionID] {
+		close(ch)
+	}
+	delete(b.subscribers, executionID)
+}
+
+// Publish fans line out to every current subscriber of its execution. A
+// subscriber whose buffer is full is skipped rather than blocking the
+// publisher; it can always catch up via LogsRepository.GetLogs on reconnect.
+func (b *Broadcaster) Publish(line *protofiles.LogLine) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[line.ExecutionId] {
+		select {
+		case ch <- line:
+		default:
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package broadcaster
+
+import (
+	"octavius/internal/pkg/protofiles"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Broadcaster_PublishDeliversToSubscriber(t *testing.T) {
+	b := New()
+	ch, unsubscribe := b.Subscribe("exec-1")
+	defer unsubscribe()
+
+	b.Publish(&protofiles.LogLine{ExecutionId: "exec-1", Seq: 0, Line: "hello"})
+
+	received := <-ch
+	assert.Equal(t, "hello", received.Line)
+}
+
+func Test_Broadcaster_PublishOnlyReachesMatchingExecution(t *testing.T) {
+	b := New()
+	ch, unsubscribe := b.Subscribe("exec-1")
+	defer unsubscribe()
+
+	b.Publish(&protofiles.LogLine{ExecutionId: "exec-2", Seq: 0, Line: "other execution"})
+
+	select {
+	case <-ch:
+		t.Error("subscriber for exec-1 should not receive a line for exec-2")
+	default:
+	}
+}
+
+func Test_Broadcaster_FanOutToMultipleSubscribers(t *testing.T) {
+	b := New()
+	ch1, unsubscribe1 := b.Subscribe("exec-1")
+	defer unsubscribe1()
+	ch2, unsubscribe2 := b.Subscribe("exec-1")
+	defer unsubscribe2()
+
+	b.Publish(&protofiles.LogLine{ExecutionId: "exec-1", Seq: 0, Line: "hello"})
+
+	assert.Equal(t, "hello", (<-ch1).Line)
+	assert.Equal(t, "hello", (<-ch2).Line)
+}
+
+func Test_Broadcaster_UnsubscribeClosesChannel(t *testing.T) {
+	b := New()
+	ch, unsubscribe := b.Subscribe("exec-1")
+	unsubscribe()
+
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func Test_Broadcaster_FinishClosesExistingSubscribers(t *testing.T) {
+	b := New()
+	ch, unsubscribe := b.Subscribe("exec-1")
+	defer unsubscribe()
+
+	b.Finish("exec-1")
+
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func Test_Broadcaster_SubscribeAfterFinishReturnsClosedChannel(t *testing.T) {
+	b := New()
+	b.Finish("exec-1")
+
+	ch, unsubscribe := b.Subscribe("exec-1")
+	defer unsubscribe()
+
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func Test_Broadcaster_UnsubscribeAfterFinishDoesNotPanic(t *testing.T) {
+	b := New()
+	ch, unsubscribe := b.Subscribe("exec-1")
+	b.Finish("exec-1")
+
+	assert.NotPanics(t, unsubscribe)
+
+	_, ok := <-ch
+	assert.False(t, ok)
+}
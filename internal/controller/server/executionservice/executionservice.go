@@ -0,0 +1,70 @@
+// Package executionservice implements the ExecutionService gRPC server: it
+// authorizes a job execution request against the job's metadata before
+// handing it to an Executor, and lists only the jobs the caller is
+// authorized to run.
+package executionservice
+
+import (
+	"context"
+	"octavius/internal/controller/server/repository/metadata"
+	"octavius/internal/pkg/auth"
+	"octavius/internal/pkg/constant"
+	"octavius/internal/pkg/protofiles"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Executor runs an already-authorized job under executionID, so its output
+// can be appended to that execution's log stream. It keeps Server decoupled
+// from how a job is actually run, the same way scheduler.Executor decouples
+// the dispatcher from it.
+type Executor interface {
+	Execute(ctx context.Context, executionID, jobName string, jobData map[string]string) error
+}
+
+// Server implements protofiles.ExecutionServiceServer.
+type Server struct {
+	protofiles.UnimplementedExecutionServiceServer
+
+	metadataRepository metadata.MetadataRepository
+	executor           Executor
+}
+
+// NewServer returns a Server backed by metadataRepository for job lookup and
+// authorization, and executor to run a job once it has been authorized.
+func NewServer(metadataRepository metadata.MetadataRepository, executor Executor) *Server {
+	return &Server{metadataRepository: metadataRepository, executor: executor}
+}
+
+// Execute looks up req.JobName's metadata and rejects the call unless the
+// caller is authorized to run it, then hands the request to the executor
+// under a freshly generated execution id that the caller can use to stream
+// its logs.
+func (s *Server) Execute(ctx context.Context, req *protofiles.ExecutionRequest) (*protofiles.ExecutionResponse, error) {
+	jobMetadata, err := s.metadataRepository.GetMetadata(ctx, req.JobName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !metadata.IsAuthorized(ctx, jobMetadata) {
+		return nil, status.Error(codes.PermissionDenied, constant.NotAuthorized)
+	}
+
+	executionID := uuid.New().String()
+	if err := s.executor.Execute(ctx, executionID, req.JobName, req.JobData); err != nil {
+		return nil, err
+	}
+
+	return &protofiles.ExecutionResponse{Status: "accepted", ExecutionId: executionID}, nil
+}
+
+// ListAvailableJobs lists only the jobs the caller is authorized to run.
+func (s *Server) ListAvailableJobs(ctx context.Context, _ *protofiles.Empty) (*protofiles.JobList, error) {
+	var groups []string
+	if userDetail, ok := auth.FromContext(ctx); ok {
+		groups = userDetail.Groups
+	}
+	return s.metadataRepository.GetAvailableJobsByGroups(ctx, groups)
+}
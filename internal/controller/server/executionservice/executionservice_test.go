@@ -0,0 +1,147 @@
+package executionservice
+
+import (
+	"context"
+	"errors"
+	"octavius/internal/controller/server/repository/metadata"
+	"octavius/internal/pkg/auth"
+	"octavius/internal/pkg/constant"
+	"octavius/internal/pkg/db/etcd"
+	"octavius/internal/pkg/log"
+	"octavius/internal/pkg/protofiles"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	log.Init("info", "", false, 1)
+}
+
+// fakeExecutor is a minimal Executor test double that records whether it was
+// invoked, so tests can assert a rejected request never reaches it.
+type fakeExecutor struct {
+	called      bool
+	executionID string
+	jobName     string
+	jobData     map[string]string
+	err         error
+}
+
+func (f *fakeExecutor) Execute(ctx context.Context, executionID, jobName string, jobData map[string]string) error {
+	f.called = true
+	f.executionID = executionID
+	f.jobName = jobName
+	f.jobData = jobData
+	return f.err
+}
+
+func qaContext() context.Context {
+	return auth.NewContext(context.Background(), &auth.UserDetail{Username: "littlestar642", Groups: []string{"qa"}})
+}
+
+func Test_Server_Execute_RunsAuthorizedJob(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	val, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name", AuthorizedGroups: []string{"qa"}})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+	mockClient.On("GetValue", "metadata/demo-image-name").Return(string(val), nil)
+
+	executor := &fakeExecutor{}
+	server := NewServer(metadata.NewMetadataRepository(mockClient), executor)
+
+	res, err := server.Execute(qaContext(), &protofiles.ExecutionRequest{JobName: "demo-image-name", JobData: map[string]string{"env": "dev"}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "accepted", res.Status)
+	assert.NotEmpty(t, res.ExecutionId)
+	assert.True(t, executor.called)
+	assert.Equal(t, res.ExecutionId, executor.executionID)
+	assert.Equal(t, map[string]string{"env": "dev"}, executor.jobData)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_Server_Execute_RejectsUnauthorizedCaller(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	val, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name", AuthorizedGroups: []string{"finance"}})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+	mockClient.On("GetValue", "metadata/demo-image-name").Return(string(val), nil)
+
+	executor := &fakeExecutor{}
+	server := NewServer(metadata.NewMetadataRepository(mockClient), executor)
+
+	_, err = server.Execute(qaContext(), &protofiles.ExecutionRequest{JobName: "demo-image-name"})
+
+	assert.Equal(t, status.Error(codes.PermissionDenied, constant.NotAuthorized).Error(), err.Error())
+	assert.False(t, executor.called)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_Server_Execute_PropagatesExecutorError(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	val, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name"})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+	mockClient.On("GetValue", "metadata/demo-image-name").Return(string(val), nil)
+
+	executor := &fakeExecutor{err: errors.New("executor unavailable")}
+	server := NewServer(metadata.NewMetadataRepository(mockClient), executor)
+
+	_, err = server.Execute(context.Background(), &protofiles.ExecutionRequest{JobName: "demo-image-name"})
+
+	assert.Equal(t, "executor unavailable", err.Error())
+	mockClient.AssertExpectations(t)
+}
+
+func Test_Server_ListAvailableJobs_FiltersByCallerGroups(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	restricted, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name", AuthorizedGroups: []string{"qa"}})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+	notAuthorized, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name-1", AuthorizedGroups: []string{"finance"}})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+
+	keys := []string{"metadata/demo-image-name", "metadata/demo-image-name-1"}
+	values := []string{string(restricted), string(notAuthorized)}
+	mockClient.On("GetAllKeyAndValues", "metadata/").Return(keys, values, nil)
+
+	server := NewServer(metadata.NewMetadataRepository(mockClient), &fakeExecutor{})
+	res, err := server.ListAvailableJobs(qaContext(), &protofiles.Empty{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &protofiles.JobList{Jobs: []string{"demo-image-name"}}, res)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_Server_ListAvailableJobs_AnonymousCallerSeesOnlyOpenJobs(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	restricted, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name", AuthorizedGroups: []string{"qa"}})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+	open, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name-1"})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+
+	keys := []string{"metadata/demo-image-name", "metadata/demo-image-name-1"}
+	values := []string{string(restricted), string(open)}
+	mockClient.On("GetAllKeyAndValues", "metadata/").Return(keys, values, nil)
+
+	server := NewServer(metadata.NewMetadataRepository(mockClient), &fakeExecutor{})
+	res, err := server.ListAvailableJobs(context.Background(), &protofiles.Empty{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &protofiles.JobList{Jobs: []string{"demo-image-name-1"}}, res)
+	mockClient.AssertExpectations(t)
+}
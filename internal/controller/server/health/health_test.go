@@ -0,0 +1,103 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"octavius/internal/pkg/db/etcd"
+	"octavius/internal/pkg/log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func init() {
+	log.Init("info", "", false, 1)
+}
+
+func Test_Checker_Check_NotServingBeforeStartup(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	checker := NewChecker(mockClient)
+
+	resp, err := checker.Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+}
+
+func Test_Checker_Check_NotServingWithoutRegisteredWorker(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetValue", sentinelKey).Return("ok", nil)
+
+	checker := NewChecker(mockClient)
+	checker.SetStartupComplete()
+
+	resp, err := checker.Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+}
+
+func Test_Checker_Check_Serving(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetValue", sentinelKey).Return("ok", nil)
+
+	checker := NewChecker(mockClient)
+	checker.SetStartupComplete()
+	checker.SetWorkerRegistered(true)
+
+	resp, err := checker.Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_Checker_Check_NotServingOnEtcdOutage(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetValue", sentinelKey).Return("", errors.New("etcd unavailable"))
+
+	checker := NewChecker(mockClient)
+	checker.SetStartupComplete()
+	checker.SetWorkerRegistered(true)
+
+	resp, err := checker.Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_Checker_Check_EtcdRecoversAfterOutage(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetValue", sentinelKey).Return("", errors.New("etcd unavailable")).Once()
+	mockClient.On("GetValue", sentinelKey).Return("ok", nil).Once()
+
+	checker := NewChecker(mockClient)
+	checker.SetStartupComplete()
+	checker.SetWorkerRegistered(true)
+
+	firstResp, err := checker.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, firstResp.Status)
+
+	secondResp, err := checker.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, secondResp.Status)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_Checker_Check_NotServingOnShutdown(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetValue", sentinelKey).Return("ok", nil)
+
+	checker := NewChecker(mockClient)
+	checker.SetStartupComplete()
+	checker.SetWorkerRegistered(true)
+	checker.Shutdown()
+
+	resp, err := checker.Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+}
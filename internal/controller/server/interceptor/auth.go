@@ -0,0 +1,21 @@
+// Package interceptor holds the gRPC server interceptors registered on the daemon.
+package interceptor
+
+import (
+	"context"
+	"octavius/internal/pkg/auth"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryAuthInterceptor recovers the caller's identity from request metadata
+// and attaches it to the context so repositories further down the call chain
+// can authorize the request. Requests with no identity attached carry no
+// UserDetail further down, and the group checks treat them like a caller
+// that belongs to no group.
+func UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if userDetail, ok := auth.FromIncomingContext(ctx); ok {
+		ctx = auth.NewContext(ctx, userDetail)
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,89 @@
+// Package logservice implements the LogService gRPC server: it replays an
+// execution's stored log backlog and then tails live lines published by the
+// executor through a broadcaster.Broadcaster, so any number of concurrent
+// "octavius logs -f" callers can watch the same execution.
+package logservice
+
+import (
+	"context"
+	"octavius/internal/controller/server/broadcaster"
+	"octavius/internal/controller/server/repository/logs"
+	"octavius/internal/pkg/protofiles"
+)
+
+// Server implements protofiles.LogServiceServer.
+type Server struct {
+	protofiles.UnimplementedLogServiceServer
+
+	logsRepository logs.LogsRepository
+	broadcaster    *broadcaster.Broadcaster
+}
+
+// NewServer returns a Server backed by logsRepository for replay and b for
+// live fan-out.
+func NewServer(logsRepository logs.LogsRepository, b *broadcaster.Broadcaster) *Server {
+	return &Server{logsRepository: logsRepository, broadcaster: b}
+}
+
+// StreamExecutionLogs replays every stored line from req.FromSeq, then
+// subscribes to the broadcaster so newly published lines are forwarded as
+// they arrive. It returns once the caller disconnects, or once
+// FinishExecution has been called for req.ExecutionId and every line
+// published before that point has been sent.
+func (s *Server) StreamExecutionLogs(req *protofiles.LogsRequest, stream protofiles.LogService_StreamExecutionLogsServer) error {
+	// Subscribe before reading the backlog so a line appended concurrently
+	// with GetLogs is never missed: it either lands in the backlog, or
+	// arrives on live, or both - the lastSeq check below drops the duplicate.
+	live, unsubscribe := s.broadcaster.Subscribe(req.ExecutionId)
+	defer unsubscribe()
+
+	backlog, err := s.logsRepository.GetLogs(stream.Context(), req.ExecutionId, req.FromSeq)
+	if err != nil {
+		return err
+	}
+
+	lastSeq := req.FromSeq - 1
+	for _, line := range backlog {
+		if err := stream.Send(line); err != nil {
+			return err
+		}
+		lastSeq = line.Seq
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case line, ok := <-live:
+			if !ok {
+				return nil
+			}
+			if line.Seq <= lastSeq {
+				continue
+			}
+			if err := stream.Send(line); err != nil {
+				return err
+			}
+			lastSeq = line.Seq
+		}
+	}
+}
+
+// AppendLog persists line for executionID and fans it out to any live
+// subscribers. Executors call this as a job produces output.
+func (s *Server) AppendLog(ctx context.Context, executionID string, line string) error {
+	logLine, err := s.logsRepository.AppendLog(ctx, executionID, line)
+	if err != nil {
+		return err
+	}
+	s.broadcaster.Publish(logLine)
+	return nil
+}
+
+// FinishExecution marks executionID as having produced no further log
+// lines, so every StreamExecutionLogs call for it - in progress or still to
+// come - returns once it has drained whatever was published before this
+// call. The executor calls this once the job's process exits.
+func (s *Server) FinishExecution(executionID string) {
+	s.broadcaster.Finish(executionID)
+}
@@ -0,0 +1,125 @@
+package logservice
+
+import (
+	"context"
+	"octavius/internal/controller/server/broadcaster"
+	"octavius/internal/controller/server/repository/logs"
+	"octavius/internal/pkg/db/etcd"
+	"octavius/internal/pkg/log"
+	"octavius/internal/pkg/protofiles"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func init() {
+	log.Init("info", "", false, 1)
+}
+
+// fakeStream is a minimal grpc.ServerStream stand-in for driving
+// Server.StreamExecutionLogs directly, without a real gRPC connection.
+type fakeStream struct {
+	ctx      context.Context
+	received []*protofiles.LogLine
+}
+
+func newFakeStream(ctx context.Context) *fakeStream {
+	return &fakeStream{ctx: ctx}
+}
+
+func (f *fakeStream) Send(line *protofiles.LogLine) error {
+	f.received = append(f.received, line)
+	return nil
+}
+
+func (f *fakeStream) Context() context.Context     { return f.ctx }
+func (f *fakeStream) SetHeader(metadata.MD) error  { return nil }
+func (f *fakeStream) SendHeader(metadata.MD) error { return nil }
+func (f *fakeStream) SetTrailer(metadata.MD)       {}
+func (f *fakeStream) SendMsg(m interface{}) error  { return nil }
+func (f *fakeStream) RecvMsg(m interface{}) error  { return nil }
+
+func Test_Server_AppendLog_PublishesToBroadcaster(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").Return([]string{}, []string{}, nil)
+	mockClient.On("PutIfAbsent", "logs/exec-1/00000000000000000000", "hello").Return(true, nil)
+
+	b := broadcaster.New()
+	ch, unsubscribe := b.Subscribe("exec-1")
+	defer unsubscribe()
+
+	server := NewServer(logs.NewLogsRepository(mockClient), b)
+	err := server.AppendLog(context.Background(), "exec-1", "hello")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", (<-ch).Line)
+	mockClient.AssertExpectations(t)
+}
+
+// Test_Server_StreamExecutionLogs_TerminatesOnFinish covers the case the
+// maintainer flagged: without FinishExecution, the handler would block
+// forever tailing a channel that never receives more lines.
+func Test_Server_StreamExecutionLogs_TerminatesOnFinish(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").
+		Return([]string{"logs/exec-1/00000000000000000000"}, []string{"hello"}, nil)
+
+	b := broadcaster.New()
+	server := NewServer(logs.NewLogsRepository(mockClient), b)
+
+	stream := newFakeStream(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StreamExecutionLogs(&protofiles.LogsRequest{ExecutionId: "exec-1", FromSeq: 0}, stream)
+	}()
+
+	// Give StreamExecutionLogs time to replay the backlog and subscribe
+	// before the execution finishes.
+	time.Sleep(10 * time.Millisecond)
+	server.FinishExecution("exec-1")
+
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("StreamExecutionLogs did not return after FinishExecution")
+	}
+
+	assert.Len(t, stream.received, 1)
+	assert.Equal(t, "hello", stream.received[0].Line)
+	mockClient.AssertExpectations(t)
+}
+
+// Test_Server_StreamExecutionLogs_AlreadyFinishedReturnsImmediately covers
+// plain `octavius logs <id>` (no -f): once an execution has finished, a
+// fresh stream should replay the backlog and return right away instead of
+// blocking.
+func Test_Server_StreamExecutionLogs_AlreadyFinishedReturnsImmediately(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").
+		Return([]string{"logs/exec-1/00000000000000000000"}, []string{"hello"}, nil)
+
+	b := broadcaster.New()
+	b.Finish("exec-1")
+	server := NewServer(logs.NewLogsRepository(mockClient), b)
+
+	stream := newFakeStream(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StreamExecutionLogs(&protofiles.LogsRequest{ExecutionId: "exec-1", FromSeq: 0}, stream)
+	}()
+
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("StreamExecutionLogs did not return for an already-finished execution")
+	}
+
+	assert.Len(t, stream.received, 1)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,97 @@
+// Package logs implements the execution log repository: it persists a job
+// execution's output lines in etcd so they survive daemon restarts and can
+// be replayed to a reconnecting "octavius logs" subscriber.
+package logs
+
+import (
+	"context"
+	"fmt"
+	"octavius/internal/pkg/constant"
+	"octavius/internal/pkg/db/etcd"
+	"octavius/internal/pkg/protofiles"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LogsRepository persists and retrieves the log lines produced by a job
+// execution, keyed under constant.LogsDir + "<execution-id>/" + <seq>.
+type LogsRepository interface {
+	// AppendLog assigns the next sequence number for executionID and
+	// persists line under it, returning the stored LogLine.
+	AppendLog(ctx context.Context, executionID string, line string) (*protofiles.LogLine, error)
+	// GetLogs returns every stored line for executionID with Seq >= fromSeq,
+	// ordered by sequence number.
+	GetLogs(ctx context.Context, executionID string, fromSeq int64) ([]*protofiles.LogLine, error)
+}
+
+type logsRepository struct {
+	etcdClient etcd.Client
+}
+
+// NewLogsRepository returns a LogsRepository backed by etcdClient.
+func NewLogsRepository(etcdClient etcd.Client) LogsRepository {
+	return &logsRepository{etcdClient: etcdClient}
+}
+
+// AppendLog picks the next sequence number from the current key count and
+// claims it with PutIfAbsent, retrying on collision, so two callers
+// appending for the same execution concurrently (e.g. separate stdout/stderr
+// readers) can never overwrite each other's line the way a plain
+// count-then-PutValue would.
+func (r *logsRepository) AppendLog(ctx context.Context, executionID string, line string) (*protofiles.LogLine, error) {
+	prefix := executionDir(executionID)
+
+	for {
+		keys, _, err := r.etcdClient.GetAllKeyAndValues(prefix)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		seq := int64(len(keys))
+		applied, err := r.etcdClient.PutIfAbsent(seqKey(prefix, seq), line)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !applied {
+			continue
+		}
+		return &protofiles.LogLine{ExecutionId: executionID, Seq: seq, Line: line}, nil
+	}
+}
+
+func (r *logsRepository) GetLogs(ctx context.Context, executionID string, fromSeq int64) ([]*protofiles.LogLine, error) {
+	prefix := executionDir(executionID)
+	keys, values, err := r.etcdClient.GetAllKeyAndValues(prefix)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lines := make([]*protofiles.LogLine, 0, len(keys))
+	for i, key := range keys {
+		seq, err := strconv.ParseInt(strings.TrimPrefix(key, prefix), 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if seq < fromSeq {
+			continue
+		}
+		lines = append(lines, &protofiles.LogLine{ExecutionId: executionID, Seq: seq, Line: values[i]})
+	}
+
+	sort.Slice(lines, func(i, j int) bool { return lines[i].Seq < lines[j].Seq })
+	return lines, nil
+}
+
+func executionDir(executionID string) string {
+	return constant.LogsDir + executionID + "/"
+}
+
+// seqKey zero-pads seq so a lexicographic etcd prefix scan already returns
+// lines in execution order.
+func seqKey(prefix string, seq int64) string {
+	return fmt.Sprintf("%s%020d", prefix, seq)
+}
@@ -0,0 +1,104 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"octavius/internal/pkg/db/etcd"
+	"octavius/internal/pkg/log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	log.Init("info", "", false, 1)
+}
+
+func Test_logsRepository_AppendLog(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").Return([]string{}, []string{}, nil)
+	mockClient.On("PutIfAbsent", "logs/exec-1/00000000000000000000", "hello").Return(true, nil)
+
+	testLogsRepo := NewLogsRepository(mockClient)
+	line, err := testLogsRepo.AppendLog(context.Background(), "exec-1", "hello")
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), line.Seq)
+	assert.Equal(t, "hello", line.Line)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_logsRepository_AppendLog_ContinuesSequence(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").
+		Return([]string{"logs/exec-1/00000000000000000000"}, []string{"hello"}, nil)
+	mockClient.On("PutIfAbsent", "logs/exec-1/00000000000000000001", "world").Return(true, nil)
+
+	testLogsRepo := NewLogsRepository(mockClient)
+	line, err := testLogsRepo.AppendLog(context.Background(), "exec-1", "world")
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), line.Seq)
+	mockClient.AssertExpectations(t)
+}
+
+// Test_logsRepository_AppendLog_RetriesOnSeqCollision covers two concurrent
+// AppendLog calls for the same execution: the loser of the PutIfAbsent race
+// must retry with the next sequence number rather than overwriting the
+// winner's line.
+func Test_logsRepository_AppendLog_RetriesOnSeqCollision(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").Return([]string{}, []string{}, nil).Once()
+	mockClient.On("PutIfAbsent", "logs/exec-1/00000000000000000000", "world").Return(false, nil).Once()
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").
+		Return([]string{"logs/exec-1/00000000000000000000"}, []string{"hello"}, nil).Once()
+	mockClient.On("PutIfAbsent", "logs/exec-1/00000000000000000001", "world").Return(true, nil).Once()
+
+	testLogsRepo := NewLogsRepository(mockClient)
+	line, err := testLogsRepo.AppendLog(context.Background(), "exec-1", "world")
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), line.Seq)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_logsRepository_AppendLog_GetAllKeyAndValuesError(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").
+		Return([]string{}, []string{}, errors.New("etcd unavailable"))
+
+	testLogsRepo := NewLogsRepository(mockClient)
+	_, err := testLogsRepo.AppendLog(context.Background(), "exec-1", "hello")
+
+	assert.NotNil(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_logsRepository_GetLogs(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	keys := []string{"logs/exec-1/00000000000000000000", "logs/exec-1/00000000000000000001", "logs/exec-1/00000000000000000002"}
+	values := []string{"line 0", "line 1", "line 2"}
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").Return(keys, values, nil)
+
+	testLogsRepo := NewLogsRepository(mockClient)
+	lines, err := testLogsRepo.GetLogs(context.Background(), "exec-1", 1)
+
+	assert.Nil(t, err)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, int64(1), lines[0].Seq)
+	assert.Equal(t, "line 1", lines[0].Line)
+	assert.Equal(t, int64(2), lines[1].Seq)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_logsRepository_GetLogs_GetAllKeyAndValuesError(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetAllKeyAndValues", "logs/exec-1/").
+		Return([]string{}, []string{}, errors.New("etcd unavailable"))
+
+	testLogsRepo := NewLogsRepository(mockClient)
+	_, err := testLogsRepo.GetLogs(context.Background(), "exec-1", 0)
+
+	assert.NotNil(t, err)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,159 @@
+// Package metadata implements metadata repository related functions
+package metadata
+
+import (
+	"context"
+	"octavius/internal/pkg/argspec"
+	"octavius/internal/pkg/auth"
+	"octavius/internal/pkg/constant"
+	"octavius/internal/pkg/db/etcd"
+	"octavius/internal/pkg/protofiles"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataRepository defines the persistence operations available for job metadata.
+type MetadataRepository interface {
+	SaveMetadata(ctx context.Context, name string, metadata *protofiles.Metadata) (*protofiles.Metadata, error)
+	GetMetadata(ctx context.Context, name string) (*protofiles.Metadata, error)
+	GetAvailableJobs(ctx context.Context) (*protofiles.JobList, error)
+	GetAvailableJobsByGroups(ctx context.Context, groups []string) (*protofiles.JobList, error)
+}
+
+type metadataRepository struct {
+	etcdClient etcd.Client
+}
+
+// NewMetadataRepository returns a MetadataRepository backed by the given etcd client.
+func NewMetadataRepository(etcdClient etcd.Client) MetadataRepository {
+	return &metadataRepository{etcdClient: etcdClient}
+}
+
+// SaveMetadata persists metadata under "metadata/<name>" in etcd. Only
+// callers belonging to constant.AdminGroup may register or update job
+// metadata; this closes the hole where any client could register a job.
+//
+// The write goes through PutIfAbsent, an etcd transaction keyed on
+// CreateRevision, rather than a separate GetValue-then-PutValue: two
+// concurrent callers racing to register the same name can otherwise both
+// observe an empty GetValue and both succeed, silently overwriting one
+// another.
+func (r *metadataRepository) SaveMetadata(ctx context.Context, name string, metadata *protofiles.Metadata) (*protofiles.Metadata, error) {
+	if !isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, constant.NotAuthorized)
+	}
+
+	if err := argspec.Validate(metadata.Args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	val, err := proto.Marshal(metadata)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	applied, err := r.etcdClient.PutIfAbsent(constant.MetadataDir+name, string(val))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !applied {
+		return nil, status.Error(codes.AlreadyExists, constant.Etcd+constant.KeyAlreadyPresent)
+	}
+
+	return metadata, nil
+}
+
+// GetMetadata fetches a single job's metadata by name.
+func (r *metadataRepository) GetMetadata(ctx context.Context, name string) (*protofiles.Metadata, error) {
+	val, err := r.etcdClient.GetValue(constant.MetadataDir + name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	metadataVal := &protofiles.Metadata{}
+	if err := proto.Unmarshal([]byte(val), metadataVal); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return metadataVal, nil
+}
+
+// GetAvailableJobs lists the names of every job registered with the server,
+// regardless of which groups are authorized to run them.
+func (r *metadataRepository) GetAvailableJobs(ctx context.Context) (*protofiles.JobList, error) {
+	keys, _, err := r.etcdClient.GetAllKeyAndValues(constant.MetadataDir)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var jobs []string
+	for _, key := range keys {
+		jobs = append(jobs, strings.TrimPrefix(key, constant.MetadataDir))
+	}
+	return &protofiles.JobList{Jobs: jobs}, nil
+}
+
+// GetAvailableJobsByGroups lists only the jobs whose AuthorizedGroups overlap
+// groups, or that have no AuthorizedGroups configured (open to everyone).
+func (r *metadataRepository) GetAvailableJobsByGroups(ctx context.Context, groups []string) (*protofiles.JobList, error) {
+	keys, values, err := r.etcdClient.GetAllKeyAndValues(constant.MetadataDir)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var jobs []string
+	for i, key := range keys {
+		metadataVal := &protofiles.Metadata{}
+		if err := proto.Unmarshal([]byte(values[i]), metadataVal); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if len(metadataVal.AuthorizedGroups) == 0 || groupsOverlap(metadataVal.AuthorizedGroups, groups) {
+			jobs = append(jobs, strings.TrimPrefix(key, constant.MetadataDir))
+		}
+	}
+	return &protofiles.JobList{Jobs: jobs}, nil
+}
+
+// IsAuthorized reports whether the caller in ctx may run a job described by
+// jobMetadata: true if the job has no AuthorizedGroups configured (open to
+// everyone), or if the caller's groups overlap AuthorizedGroups. It is the
+// execution-time counterpart to GetAvailableJobsByGroups, which only filters
+// what a caller sees listed.
+func IsAuthorized(ctx context.Context, jobMetadata *protofiles.Metadata) bool {
+	if len(jobMetadata.AuthorizedGroups) == 0 {
+		return true
+	}
+	userDetail, ok := auth.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	return groupsOverlap(jobMetadata.AuthorizedGroups, userDetail.Groups)
+}
+
+func isAdmin(ctx context.Context) bool {
+	userDetail, ok := auth.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, group := range userDetail.Groups {
+		if group == constant.AdminGroup {
+			return true
+		}
+	}
+	return false
+}
+
+func groupsOverlap(authorizedGroups, callerGroups []string) bool {
+	authorized := make(map[string]struct{}, len(authorizedGroups))
+	for _, group := range authorizedGroups {
+		authorized[group] = struct{}{}
+	}
+	for _, group := range callerGroups {
+		if _, ok := authorized[group]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@ package metadata
 import (
 	"context"
 	"errors"
+	"octavius/internal/pkg/auth"
 	"octavius/internal/pkg/constant"
 	"octavius/internal/pkg/db/etcd"
 	"octavius/internal/pkg/log"
@@ -20,6 +21,15 @@ func init() {
 	log.Init("info", "", false, 1)
 }
 
+// adminContext returns a context carrying a caller that belongs to
+// constant.AdminGroup, as the auth interceptor would attach in production.
+func adminContext() context.Context {
+	return auth.NewContext(context.Background(), &auth.UserDetail{
+		Username: "littlestar642",
+		Groups:   []string{constant.AdminGroup},
+	})
+}
+
 func Test_metadataRepository_SaveMetadata(t *testing.T) {
 	mockClient := new(etcd.ClientMock)
 	metadataVal := &protofiles.Metadata{
@@ -32,11 +42,10 @@ func Test_metadataRepository_SaveMetadata(t *testing.T) {
 	if err != nil {
 		t.Error("error in marshalling metadata")
 	}
-	mockClient.On("PutValue", "metadata/test data", string(val)).Return(nil)
-	mockClient.On("GetValue", "metadata/test data").Return("", nil)
+	mockClient.On("PutIfAbsent", "metadata/test data", string(val)).Return(true, nil)
 
 	testMetadataRepo := NewMetadataRepository(mockClient)
-	ctx := context.Background()
+	ctx := adminContext()
 	sr, err := testMetadataRepo.SaveMetadata(ctx, "test data", metadataVal)
 
 	if err != nil {
@@ -49,6 +58,12 @@ func Test_metadataRepository_SaveMetadata(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+// Test_metadataRepository_SaveMetadata_KeyAlreadyPresent covers two
+// concurrent callers racing to register the same name: PutIfAbsent's
+// transaction guarantees only one of them observes applied=true, and the
+// AlreadyExists error below is returned deterministically rather than
+// depending on goroutine scheduling, unlike the old GetValue-then-PutValue
+// approach.
 func Test_metadataRepository_SaveMetadata_KeyAlreadyPresent(t *testing.T) {
 	mockClient := new(etcd.ClientMock)
 	metadataVal := &protofiles.Metadata{
@@ -61,43 +76,19 @@ func Test_metadataRepository_SaveMetadata_KeyAlreadyPresent(t *testing.T) {
 	if err != nil {
 		t.Error("error in marshalling metadata")
 	}
-	mockClient.On("PutValue", "metadata/test data", string(val)).Return(nil)
-	mockClient.On("GetValue", "metadata/test data").Return("some key", nil)
+	mockClient.On("PutIfAbsent", "metadata/test data", string(val)).Return(false, nil)
 
 	testMetadataRepo := NewMetadataRepository(mockClient)
-	ctx := context.Background()
+	ctx := adminContext()
 	_, err = testMetadataRepo.SaveMetadata(ctx, "test data", metadataVal)
 
 	if err.Error() != status.Error(codes.AlreadyExists, constant.Etcd+constant.KeyAlreadyPresent).Error() {
 		t.Error("key already present error expected")
 	}
+	mockClient.AssertExpectations(t)
 }
 
-func Test_metadataRepository_SaveMetadata_GetValueError(t *testing.T) {
-	mockClient := new(etcd.ClientMock)
-	metadataVal := &protofiles.Metadata{
-		Author:      "littlestar642",
-		ImageName:   "demo image",
-		Name:        "test data",
-		Description: "sample test metadata",
-	}
-	val, err := proto.Marshal(metadataVal)
-	if err != nil {
-		t.Error("error in marshalling metadata")
-	}
-	mockClient.On("PutValue", "metadata/test data", string(val)).Return(nil)
-	mockClient.On("GetValue", "metadata/test data").Return("", errors.New("some error"))
-
-	testMetadataRepo := NewMetadataRepository(mockClient)
-	ctx := context.Background()
-	_, err = testMetadataRepo.SaveMetadata(ctx, "test data", metadataVal)
-
-	if err.Error() != status.Error(codes.Internal, "some error").Error() {
-		t.Error("get value error expected")
-	}
-}
-
-func Test_metadataRepository_SaveMetadata_PutValueError(t *testing.T) {
+func Test_metadataRepository_SaveMetadata_PutIfAbsentError(t *testing.T) {
 	mockClient := new(etcd.ClientMock)
 	metadataVal := &protofiles.Metadata{
 		Author:      "littlestar642",
@@ -109,16 +100,16 @@ func Test_metadataRepository_SaveMetadata_PutValueError(t *testing.T) {
 	if err != nil {
 		t.Error("error in marshalling metadata")
 	}
-	mockClient.On("PutValue", "metadata/test data", string(val)).Return(errors.New("some error"))
-	mockClient.On("GetValue", "metadata/test data").Return("", nil)
+	mockClient.On("PutIfAbsent", "metadata/test data", string(val)).Return(false, errors.New("some error"))
 
 	testMetadataRepo := NewMetadataRepository(mockClient)
-	ctx := context.Background()
+	ctx := adminContext()
 	_, err = testMetadataRepo.SaveMetadata(ctx, "test data", metadataVal)
 
 	if err.Error() != status.Error(codes.Internal, "some error").Error() {
-		t.Error("put value error expected")
+		t.Error("put if absent error expected")
 	}
+	mockClient.AssertExpectations(t)
 }
 
 func TestGetMetadata(t *testing.T) {
@@ -187,3 +178,123 @@ func Test_metadataRepository_GetAvailableJobs_ForEtcdClientFailure(t *testing.T)
 
 	mockClient.AssertExpectations(t)
 }
+
+func Test_metadataRepository_SaveMetadata_NotAuthorized(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	metadataVal := &protofiles.Metadata{
+		Author:      "littlestar642",
+		ImageName:   "demo image",
+		Name:        "test data",
+		Description: "sample test metadata",
+	}
+
+	testMetadataRepo := NewMetadataRepository(mockClient)
+	ctx := auth.NewContext(context.Background(), &auth.UserDetail{
+		Username: "nonadmin",
+		Groups:   []string{"some-other-group"},
+	})
+	_, err := testMetadataRepo.SaveMetadata(ctx, "test data", metadataVal)
+
+	if err.Error() != status.Error(codes.PermissionDenied, constant.NotAuthorized).Error() {
+		t.Error("not authorized error expected")
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func Test_metadataRepository_GetAvailableJobsByGroups(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+
+	restricted, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name", AuthorizedGroups: []string{"qa"}})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+	open, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name-1"})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+	notAuthorized, err := proto.Marshal(&protofiles.Metadata{Name: "demo-image-name-2", AuthorizedGroups: []string{"finance"}})
+	if err != nil {
+		t.Error("error in marshalling metadata")
+	}
+
+	keys := []string{"metadata/demo-image-name", "metadata/demo-image-name-1", "metadata/demo-image-name-2"}
+	values := []string{string(restricted), string(open), string(notAuthorized)}
+
+	mockClient.On("GetAllKeyAndValues", "metadata/").Return(keys, values, nil)
+
+	testMetadataRepo := NewMetadataRepository(mockClient)
+	res, err := testMetadataRepo.GetAvailableJobsByGroups(context.Background(), []string{"qa"})
+	assert.Nil(t, err)
+	assert.Equal(t, &protofiles.JobList{Jobs: []string{"demo-image-name", "demo-image-name-1"}}, res)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_IsAuthorized_OpenJobAllowsAnyCaller(t *testing.T) {
+	open := &protofiles.Metadata{Name: "demo-image-name-1"}
+	assert.True(t, IsAuthorized(context.Background(), open))
+}
+
+func Test_IsAuthorized_RestrictedJobRequiresOverlappingGroup(t *testing.T) {
+	restricted := &protofiles.Metadata{Name: "demo-image-name", AuthorizedGroups: []string{"qa"}}
+
+	ctx := auth.NewContext(context.Background(), &auth.UserDetail{Username: "littlestar642", Groups: []string{"qa"}})
+	assert.True(t, IsAuthorized(ctx, restricted))
+}
+
+func Test_IsAuthorized_RestrictedJobRejectsNonOverlappingGroup(t *testing.T) {
+	restricted := &protofiles.Metadata{Name: "demo-image-name", AuthorizedGroups: []string{"finance"}}
+
+	ctx := auth.NewContext(context.Background(), &auth.UserDetail{Username: "littlestar642", Groups: []string{"qa"}})
+	assert.False(t, IsAuthorized(ctx, restricted))
+}
+
+func Test_IsAuthorized_RestrictedJobRejectsAnonymousCaller(t *testing.T) {
+	restricted := &protofiles.Metadata{Name: "demo-image-name", AuthorizedGroups: []string{"qa"}}
+	assert.False(t, IsAuthorized(context.Background(), restricted))
+}
+
+func Test_metadataRepository_SaveMetadata_RejectsMalformedArgSpec(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	metadataVal := &protofiles.Metadata{
+		Name: "test data",
+		Args: []*protofiles.ArgSpec{
+			{Name: "environment", Type: "enum"},
+		},
+	}
+
+	testMetadataRepo := NewMetadataRepository(mockClient)
+	_, err := testMetadataRepo.SaveMetadata(adminContext(), "test data", metadataVal)
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockClient.AssertExpectations(t)
+}
+
+func Test_metadataRepository_GetMetadata_ArgsSchemaRoundTrip(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	jobName := "testJobName"
+	key := "metadata/" + jobName
+
+	testMetadata := &protofiles.Metadata{
+		Name: jobName,
+		Args: []*protofiles.ArgSpec{
+			{Name: "environment", Type: constant.ArgTypeEnum, AllowedValues: []string{"dev", "prod"}, Default: "dev"},
+			{Name: "replicas", Type: constant.ArgTypeInt, Required: true},
+		},
+	}
+
+	str, err := proto.Marshal(testMetadata)
+	if err != nil {
+		t.Error("error in marshalling test metadata")
+	}
+	mockClient.On("GetValue", key).Return(string(str), nil)
+
+	testMetadataRepo := NewMetadataRepository(mockClient)
+	resultMetadata, err := testMetadataRepo.GetMetadata(context.Background(), jobName)
+
+	assert.Nil(t, err)
+	assert.Len(t, resultMetadata.Args, 2)
+	assert.Equal(t, "environment", resultMetadata.Args[0].Name)
+	assert.Equal(t, []string{"dev", "prod"}, resultMetadata.Args[0].AllowedValues)
+	assert.True(t, resultMetadata.Args[1].Required)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,104 @@
+// Package schedule implements the repository for persisted cron schedules.
+package schedule
+
+import (
+	"context"
+	"octavius/internal/pkg/auth"
+	"octavius/internal/pkg/constant"
+	"octavius/internal/pkg/db/etcd"
+	"octavius/internal/pkg/protofiles"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScheduleRepository defines the persistence operations available for cron schedules.
+type ScheduleRepository interface {
+	SaveSchedule(ctx context.Context, schedule *protofiles.Schedule) (*protofiles.Schedule, error)
+	GetSchedule(ctx context.Context, id string) (*protofiles.Schedule, error)
+	GetScheduledJobs(ctx context.Context) (*protofiles.ScheduleList, error)
+	RemoveSchedule(ctx context.Context, id string) error
+}
+
+type scheduleRepository struct {
+	etcdClient etcd.Client
+}
+
+// NewScheduleRepository returns a ScheduleRepository backed by the given etcd client.
+func NewScheduleRepository(etcdClient etcd.Client) ScheduleRepository {
+	return &scheduleRepository{etcdClient: etcdClient}
+}
+
+// SaveSchedule persists a schedule under "schedule/<id>", assigning a new id
+// the first time a schedule is saved and overwriting the existing entry on
+// later calls (the dispatcher re-saves a schedule after every fire to record
+// its next run time). On that first save, Owner is backfilled from ctx's
+// caller identity, the same way isAdmin reads it in the metadata package, so
+// it does not depend on the CLI remembering to set it; later re-saves carry
+// the already-populated Owner through schedule untouched.
+func (r *scheduleRepository) SaveSchedule(ctx context.Context, schedule *protofiles.Schedule) (*protofiles.Schedule, error) {
+	if schedule.Id == "" {
+		schedule.Id = uuid.New().String()
+		if userDetail, ok := auth.FromContext(ctx); ok {
+			schedule.Owner = userDetail.Username
+		}
+	}
+
+	val, err := proto.Marshal(schedule)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := r.etcdClient.PutValue(constant.ScheduleDir+schedule.Id, string(val)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return schedule, nil
+}
+
+// GetSchedule fetches a single schedule by id.
+func (r *scheduleRepository) GetSchedule(ctx context.Context, id string) (*protofiles.Schedule, error) {
+	val, err := r.etcdClient.GetValue(constant.ScheduleDir + id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if val == "" {
+		return nil, status.Error(codes.NotFound, constant.Etcd+constant.ScheduleNotFound)
+	}
+
+	schedule := &protofiles.Schedule{}
+	if err := proto.Unmarshal([]byte(val), schedule); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return schedule, nil
+}
+
+// GetScheduledJobs lists every persisted schedule. It mirrors
+// metadata.GetAvailableJobs in shape but returns full Schedule records since
+// both the dispatcher and the CLI's "schedule list" need the cron expression
+// and args, not just a name.
+func (r *scheduleRepository) GetScheduledJobs(ctx context.Context) (*protofiles.ScheduleList, error) {
+	_, values, err := r.etcdClient.GetAllKeyAndValues(constant.ScheduleDir)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	schedules := make([]*protofiles.Schedule, 0, len(values))
+	for _, val := range values {
+		schedule := &protofiles.Schedule{}
+		if err := proto.Unmarshal([]byte(val), schedule); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		schedules = append(schedules, schedule)
+	}
+	return &protofiles.ScheduleList{Schedules: schedules}, nil
+}
+
+// RemoveSchedule deletes a schedule by id.
+func (r *scheduleRepository) RemoveSchedule(ctx context.Context, id string) error {
+	if err := r.etcdClient.DeleteValue(constant.ScheduleDir + id); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+// Package schedule implements the repository for persisted cron schedules.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"octavius/internal/pkg/auth"
+	"octavius/internal/pkg/constant"
+	"octavius/internal/pkg/db/etcd"
+	"octavius/internal/pkg/log"
+	"octavius/internal/pkg/protofiles"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	log.Init("info", "", false, 1)
+}
+
+func Test_scheduleRepository_SaveSchedule(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	scheduleVal := &protofiles.Schedule{
+		Id:       "test-id",
+		JobName:  "demo-image-name",
+		CronExpr: "0 * * * *",
+		Owner:    "littlestar642",
+	}
+	val, err := proto.Marshal(scheduleVal)
+	if err != nil {
+		t.Error("error in marshalling schedule")
+	}
+	mockClient.On("PutValue", "schedule/test-id", string(val)).Return(nil)
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	res, err := testScheduleRepo.SaveSchedule(context.Background(), scheduleVal)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "test-id", res.Id)
+	mockClient.AssertExpectations(t)
+}
+
+// Test_scheduleRepository_SaveSchedule_BackfillsOwnerOnCreate covers the case
+// the maintainer flagged: Owner was never set, even though audit.Record reads
+// it on every schedule fire.
+func Test_scheduleRepository_SaveSchedule_BackfillsOwnerOnCreate(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("PutValue", mock.Anything, mock.Anything).Return(nil)
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	ctx := auth.NewContext(context.Background(), &auth.UserDetail{Username: "littlestar642"})
+	res, err := testScheduleRepo.SaveSchedule(ctx, &protofiles.Schedule{
+		JobName:  "demo-image-name",
+		CronExpr: "0 * * * *",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "littlestar642", res.Owner)
+	mockClient.AssertExpectations(t)
+}
+
+// Test_scheduleRepository_SaveSchedule_ReSaveKeepsOwner covers the
+// dispatcher's re-save of an existing schedule after it fires: Owner must
+// survive unchanged even though the re-save's context carries no caller
+// identity (the dispatcher re-saves with its own Run context, not a caller's).
+func Test_scheduleRepository_SaveSchedule_ReSaveKeepsOwner(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	scheduleVal := &protofiles.Schedule{
+		Id:       "test-id",
+		JobName:  "demo-image-name",
+		CronExpr: "0 * * * *",
+		Owner:    "littlestar642",
+	}
+	val, err := proto.Marshal(scheduleVal)
+	if err != nil {
+		t.Error("error in marshalling schedule")
+	}
+	mockClient.On("PutValue", "schedule/test-id", string(val)).Return(nil)
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	res, err := testScheduleRepo.SaveSchedule(context.Background(), scheduleVal)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "littlestar642", res.Owner)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_scheduleRepository_SaveSchedule_PutValueError(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	scheduleVal := &protofiles.Schedule{
+		Id:       "test-id",
+		JobName:  "demo-image-name",
+		CronExpr: "0 * * * *",
+	}
+	val, err := proto.Marshal(scheduleVal)
+	if err != nil {
+		t.Error("error in marshalling schedule")
+	}
+	mockClient.On("PutValue", "schedule/test-id", string(val)).Return(errors.New("some error"))
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	_, err = testScheduleRepo.SaveSchedule(context.Background(), scheduleVal)
+
+	assert.Equal(t, status.Error(codes.Internal, "some error").Error(), err.Error())
+}
+
+func Test_scheduleRepository_GetSchedule(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	scheduleVal := &protofiles.Schedule{
+		Id:       "test-id",
+		JobName:  "demo-image-name",
+		CronExpr: "0 * * * *",
+	}
+	val, err := proto.Marshal(scheduleVal)
+	if err != nil {
+		t.Error("error in marshalling schedule")
+	}
+	mockClient.On("GetValue", "schedule/test-id").Return(string(val), nil)
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	res, err := testScheduleRepo.GetSchedule(context.Background(), "test-id")
+
+	assert.Nil(t, err)
+	assert.Equal(t, scheduleVal.JobName, res.JobName)
+	assert.Equal(t, scheduleVal.CronExpr, res.CronExpr)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_scheduleRepository_GetSchedule_NotFound(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("GetValue", "schedule/missing-id").Return("", nil)
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	_, err := testScheduleRepo.GetSchedule(context.Background(), "missing-id")
+
+	assert.Equal(t, status.Error(codes.NotFound, constant.Etcd+constant.ScheduleNotFound).Error(), err.Error())
+}
+
+func Test_scheduleRepository_GetScheduledJobs(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+
+	first, err := proto.Marshal(&protofiles.Schedule{Id: "id-1", JobName: "demo-image-name", CronExpr: "0 * * * *"})
+	if err != nil {
+		t.Error("error in marshalling schedule")
+	}
+	second, err := proto.Marshal(&protofiles.Schedule{Id: "id-2", JobName: "demo-image-name-1", CronExpr: "*/5 * * * *"})
+	if err != nil {
+		t.Error("error in marshalling schedule")
+	}
+
+	var keys []string
+	keys = append(keys, "schedule/id-1", "schedule/id-2")
+	values := []string{string(first), string(second)}
+
+	mockClient.On("GetAllKeyAndValues", "schedule/").Return(keys, values, nil)
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	res, err := testScheduleRepo.GetScheduledJobs(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, res.Schedules, 2)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_scheduleRepository_GetScheduledJobs_ForEtcdClientFailure(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+
+	var keys []string
+	var values []string
+	mockClient.On("GetAllKeyAndValues", "schedule/").Return(keys, values, errors.New("error in etcd"))
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	_, err := testScheduleRepo.GetScheduledJobs(context.Background())
+
+	assert.Equal(t, status.Error(codes.Internal, "error in etcd").Error(), err.Error())
+	mockClient.AssertExpectations(t)
+}
+
+func Test_scheduleRepository_RemoveSchedule(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("DeleteValue", "schedule/test-id").Return(nil)
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	err := testScheduleRepo.RemoveSchedule(context.Background(), "test-id")
+
+	assert.Nil(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func Test_scheduleRepository_RemoveSchedule_Error(t *testing.T) {
+	mockClient := new(etcd.ClientMock)
+	mockClient.On("DeleteValue", "schedule/test-id").Return(errors.New("some error"))
+
+	testScheduleRepo := NewScheduleRepository(mockClient)
+	err := testScheduleRepo.RemoveSchedule(context.Background(), "test-id")
+
+	assert.Equal(t, status.Error(codes.Internal, "some error").Error(), err.Error())
+}
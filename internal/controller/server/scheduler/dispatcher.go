@@ -0,0 +1,111 @@
+// Package scheduler runs the leader-elected loop that fires scheduled jobs
+// at their configured cron cadence.
+package scheduler
+
+import (
+	"context"
+	"octavius/internal/controller/server/audit"
+	"octavius/internal/controller/server/repository/schedule"
+	"octavius/internal/pkg/log"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+const electionPrefix = "schedule/election"
+
+// Executor runs a job the same way the execute command's RPC does. It keeps
+// the dispatcher decoupled from how a job is actually executed.
+type Executor interface {
+	Execute(ctx context.Context, jobName string, jobData map[string]string) error
+}
+
+// Dispatcher polls due schedules and fires them once leadership is held, so
+// a schedule is only ever executed by a single server instance even when
+// several daemons are running against the same etcd cluster.
+type Dispatcher struct {
+	etcdClient   *clientv3.Client
+	repo         schedule.ScheduleRepository
+	executor     Executor
+	tickInterval time.Duration
+}
+
+// NewDispatcher returns a Dispatcher that fires due schedules from repo via executor.
+func NewDispatcher(etcdClient *clientv3.Client, repo schedule.ScheduleRepository, executor Executor) *Dispatcher {
+	return &Dispatcher{
+		etcdClient:   etcdClient,
+		repo:         repo,
+		executor:     executor,
+		tickInterval: time.Minute,
+	}
+}
+
+// Run campaigns for leadership and, once elected, fires due schedules every
+// tick until ctx is cancelled. It blocks, so callers should run it in its own
+// goroutine.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	session, err := concurrency.NewSession(d.etcdClient)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	election := concurrency.NewElection(session, electionPrefix)
+	if err := election.Campaign(ctx, "leader"); err != nil {
+		return err
+	}
+	log.Info("became the schedule dispatcher leader")
+
+	ticker := time.NewTicker(d.tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return election.Resign(context.Background())
+		case <-ticker.C:
+			d.fireDueSchedules(ctx)
+		}
+	}
+}
+
+func (d *Dispatcher) fireDueSchedules(ctx context.Context) {
+	schedules, err := d.repo.GetScheduledJobs(ctx)
+	if err != nil {
+		log.Error(err, "error in fetching scheduled jobs")
+		return
+	}
+
+	now := time.Now().Unix()
+	for _, s := range schedules.Schedules {
+		if s.NextRunTimestamp > now {
+			continue
+		}
+
+		if err := d.executor.Execute(ctx, s.JobName, s.Args); err != nil {
+			log.Error(err, "error in firing scheduled job "+s.JobName)
+			continue
+		}
+		audit.Record(audit.Entry{Action: "schedule.fire", JobName: s.JobName, Owner: s.Owner, Metadata: s.Args})
+
+		next, err := nextRun(s.CronExpr)
+		if err != nil {
+			log.Error(err, "error in computing next run for schedule "+s.Id)
+			continue
+		}
+		s.NextRunTimestamp = next
+		if _, err := d.repo.SaveSchedule(ctx, s); err != nil {
+			log.Error(err, "error in persisting next run for schedule "+s.Id)
+		}
+	}
+}
+
+func nextRun(cronExpr string) (int64, error) {
+	parsedSchedule, err := cron.ParseStandard(cronExpr)
+	if err != nil {
+		return 0, err
+	}
+	return parsedSchedule.Next(time.Now()).Unix(), nil
+}
@@ -0,0 +1,133 @@
+// Package argspec validates protofiles.ArgSpec schemas and applies them to
+// the `key=value` arguments a caller passes to `octavius execute` and
+// `octavius schedule`, so malformed input is rejected before it ever reaches
+// the daemon.
+package argspec
+
+import (
+	"fmt"
+	"octavius/internal/pkg/constant"
+	"octavius/internal/pkg/protofiles"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Validate checks that every ArgSpec in specs declares a supported type and
+// an internally consistent set of constraints. It is used by SaveMetadata to
+// reject malformed schemas at registration time rather than at every
+// execution.
+func Validate(specs []*protofiles.ArgSpec) error {
+	seen := make(map[string]struct{}, len(specs))
+	for _, spec := range specs {
+		if spec.Name == "" {
+			return fmt.Errorf("%s: arg spec is missing a name", constant.InvalidArgSpec)
+		}
+		if _, duplicate := seen[spec.Name]; duplicate {
+			return fmt.Errorf("%s: duplicate arg %q", constant.InvalidArgSpec, spec.Name)
+		}
+		seen[spec.Name] = struct{}{}
+
+		switch spec.Type {
+		case constant.ArgTypeString, constant.ArgTypeInt, constant.ArgTypeBool, constant.ArgTypeEnum:
+		default:
+			return fmt.Errorf("%s: arg %q has unsupported type %q", constant.InvalidArgSpec, spec.Name, spec.Type)
+		}
+
+		if spec.Type == constant.ArgTypeEnum && len(spec.AllowedValues) == 0 {
+			return fmt.Errorf("%s: enum arg %q must declare allowed_values", constant.InvalidArgSpec, spec.Name)
+		}
+
+		if spec.Regex != "" {
+			if _, err := regexp.Compile(spec.Regex); err != nil {
+				return fmt.Errorf("%s: arg %q has invalid regex: %w", constant.InvalidArgSpec, spec.Name, err)
+			}
+		}
+
+		if spec.Default != "" {
+			if err := coerce(spec, spec.Default); err != nil {
+				return fmt.Errorf("%s: arg %q has a default that fails its own constraints: %w", constant.InvalidArgSpec, spec.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Parse splits each "key=value" token in rawArgs, checks its value against
+// the spec's type and constraints (the value is kept as given, not
+// normalized), fills in defaults for any optional arg the caller omitted,
+// and fails fast on unknown args, missing required args, or a token with no
+// "=" instead of panicking on an out-of-range slice index.
+func Parse(specs []*protofiles.ArgSpec, rawArgs []string) (map[string]string, error) {
+	byName := make(map[string]*protofiles.ArgSpec, len(specs))
+	for _, spec := range specs {
+		byName[spec.Name] = spec
+	}
+
+	jobData := make(map[string]string, len(specs))
+	for _, raw := range rawArgs {
+		name, value, ok := strings.Cut(raw, "=")
+		if !ok {
+			return nil, fmt.Errorf("arg %q is not in key=value form", raw)
+		}
+
+		spec, known := byName[name]
+		if !known {
+			return nil, fmt.Errorf("unknown arg %q", name)
+		}
+		if err := coerce(spec, value); err != nil {
+			return nil, fmt.Errorf("arg %q: %w", name, err)
+		}
+		jobData[name] = value
+	}
+
+	for _, spec := range specs {
+		if _, provided := jobData[spec.Name]; provided {
+			continue
+		}
+		if spec.Required {
+			return nil, fmt.Errorf("missing required arg %q", spec.Name)
+		}
+		if spec.Default != "" {
+			jobData[spec.Name] = spec.Default
+		}
+	}
+
+	return jobData, nil
+}
+
+// coerce reports whether value satisfies spec's type and constraints.
+func coerce(spec *protofiles.ArgSpec, value string) error {
+	switch spec.Type {
+	case constant.ArgTypeInt:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("expected an int, got %q", value)
+		}
+	case constant.ArgTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("expected a bool, got %q", value)
+		}
+	case constant.ArgTypeEnum:
+		allowed := false
+		for _, candidate := range spec.AllowedValues {
+			if candidate == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%q is not one of %v", value, spec.AllowedValues)
+		}
+	}
+
+	if spec.Regex != "" {
+		matched, err := regexp.MatchString(spec.Regex, value)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			return fmt.Errorf("%q does not match pattern %q", value, spec.Regex)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package argspec
+
+import (
+	"octavius/internal/pkg/constant"
+	"octavius/internal/pkg/protofiles"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validate_RejectsUnsupportedType(t *testing.T) {
+	err := Validate([]*protofiles.ArgSpec{{Name: "count", Type: "float"}})
+	assert.NotNil(t, err)
+}
+
+func Test_Validate_RejectsEnumWithoutAllowedValues(t *testing.T) {
+	err := Validate([]*protofiles.ArgSpec{{Name: "env", Type: constant.ArgTypeEnum}})
+	assert.NotNil(t, err)
+}
+
+func Test_Validate_RejectsDuplicateArgNames(t *testing.T) {
+	err := Validate([]*protofiles.ArgSpec{
+		{Name: "count", Type: constant.ArgTypeInt},
+		{Name: "count", Type: constant.ArgTypeString},
+	})
+	assert.NotNil(t, err)
+}
+
+func Test_Validate_Accepts(t *testing.T) {
+	err := Validate([]*protofiles.ArgSpec{
+		{Name: "env", Type: constant.ArgTypeEnum, AllowedValues: []string{"dev", "prod"}, Default: "dev"},
+		{Name: "count", Type: constant.ArgTypeInt, Required: true},
+	})
+	assert.Nil(t, err)
+}
+
+func Test_Parse_FillsDefaultsAndCoercesTypes(t *testing.T) {
+	specs := []*protofiles.ArgSpec{
+		{Name: "count", Type: constant.ArgTypeInt, Required: true},
+		{Name: "env", Type: constant.ArgTypeEnum, AllowedValues: []string{"dev", "prod"}, Default: "dev"},
+	}
+
+	jobData, err := Parse(specs, []string{"count=3"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "3", jobData["count"])
+	assert.Equal(t, "dev", jobData["env"])
+}
+
+func Test_Parse_FailsOnMissingEquals(t *testing.T) {
+	specs := []*protofiles.ArgSpec{{Name: "count", Type: constant.ArgTypeInt}}
+	_, err := Parse(specs, []string{"count"})
+	assert.NotNil(t, err)
+}
+
+func Test_Parse_FailsOnUnknownArg(t *testing.T) {
+	specs := []*protofiles.ArgSpec{{Name: "count", Type: constant.ArgTypeInt}}
+	_, err := Parse(specs, []string{"unknown=1"})
+	assert.NotNil(t, err)
+}
+
+func Test_Parse_FailsOnMissingRequiredArg(t *testing.T) {
+	specs := []*protofiles.ArgSpec{{Name: "count", Type: constant.ArgTypeInt, Required: true}}
+	_, err := Parse(specs, []string{})
+	assert.NotNil(t, err)
+}
+
+func Test_Parse_FailsOnTypeMismatch(t *testing.T) {
+	specs := []*protofiles.ArgSpec{{Name: "count", Type: constant.ArgTypeInt}}
+	_, err := Parse(specs, []string{"count=notanumber"})
+	assert.NotNil(t, err)
+}
+
+func Test_Parse_FailsOnRegexMismatch(t *testing.T) {
+	specs := []*protofiles.ArgSpec{{Name: "image", Type: constant.ArgTypeString, Regex: `^[a-z]+$`}}
+	_, err := Parse(specs, []string{"image=BadImage123"})
+	assert.NotNil(t, err)
+}
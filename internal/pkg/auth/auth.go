@@ -0,0 +1,110 @@
+// Package auth provides the caller-identity primitives shared by the CLI and
+// the daemon: loading ~/.octavius/auth.yaml on the client side, and threading
+// the resulting UserDetail through gRPC metadata and request contexts on the
+// server side.
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v2"
+)
+
+// UserDetail identifies the caller of a request and the groups they belong to.
+type UserDetail struct {
+	Username string   `yaml:"username"`
+	Groups   []string `yaml:"groups"`
+}
+
+const (
+	usernameMetadataKey = "octavius-username"
+	groupsMetadataKey   = "octavius-groups"
+)
+
+// DefaultConfigPath returns the location of the CLI's local auth file,
+// "~/.octavius/auth.yaml".
+func DefaultConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".octavius", "auth.yaml"), nil
+}
+
+// Load reads and parses the caller's identity from ~/.octavius/auth.yaml.
+func Load() (*UserDetail, error) {
+	path, err := DefaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	return LoadFromFile(path)
+}
+
+// LoadFromFile parses a UserDetail from the yaml file at path.
+func LoadFromFile(path string) (*UserDetail, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	userDetail := &UserDetail{}
+	if err := yaml.Unmarshal(data, userDetail); err != nil {
+		return nil, err
+	}
+	return userDetail, nil
+}
+
+// OutgoingContext loads the caller's identity from ~/.octavius/auth.yaml and
+// returns a context carrying it as gRPC request metadata, ready to pass to
+// any daemon RPC call.
+func OutgoingContext() (context.Context, error) {
+	userDetail, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return NewOutgoingContext(context.Background(), userDetail), nil
+}
+
+// NewOutgoingContext attaches the caller's identity to ctx as gRPC request
+// metadata so the daemon's auth interceptor can recover it server-side.
+func NewOutgoingContext(ctx context.Context, userDetail *UserDetail) context.Context {
+	md := metadata.Pairs(usernameMetadataKey, userDetail.Username)
+	for _, group := range userDetail.Groups {
+		md.Append(groupsMetadataKey, group)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// FromIncomingContext recovers the caller's identity from gRPC request
+// metadata. It is used by the server-side auth interceptor.
+func FromIncomingContext(ctx context.Context) (*UserDetail, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	usernames := md.Get(usernameMetadataKey)
+	if len(usernames) == 0 {
+		return nil, false
+	}
+	return &UserDetail{
+		Username: usernames[0],
+		Groups:   md.Get(groupsMetadataKey),
+	}, true
+}
+
+type contextKey struct{}
+
+// NewContext attaches userDetail to ctx for handlers and repositories further
+// down the call chain to read via FromContext.
+func NewContext(ctx context.Context, userDetail *UserDetail) context.Context {
+	return context.WithValue(ctx, contextKey{}, userDetail)
+}
+
+// FromContext returns the UserDetail previously attached by NewContext, if any.
+func FromContext(ctx context.Context) (*UserDetail, bool) {
+	userDetail, ok := ctx.Value(contextKey{}).(*UserDetail)
+	return userDetail, ok
+}
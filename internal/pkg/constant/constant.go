@@ -0,0 +1,42 @@
+// Package constant holds shared string constants used for building
+// consistent error messages and etcd key prefixes across the server.
+package constant
+
+const (
+	// Etcd is prefixed to every error message that originates from the etcd layer.
+	Etcd = "etcd: "
+
+	// KeyAlreadyPresent is returned when a metadata key already exists on save.
+	KeyAlreadyPresent = "key already present"
+
+	// MetadataDir is the etcd key prefix under which job metadata is stored.
+	MetadataDir = "metadata/"
+
+	// NotAuthorized is returned when a caller's groups do not overlap the
+	// groups required to perform an action.
+	NotAuthorized = "caller is not authorized to perform this action"
+
+	// AdminGroup is the group that is allowed to register and update job metadata.
+	AdminGroup = "octavius-admin"
+
+	// ScheduleDir is the etcd key prefix under which cron schedules are stored.
+	ScheduleDir = "schedule/"
+
+	// ScheduleNotFound is returned when a schedule id has no corresponding etcd entry.
+	ScheduleNotFound = "schedule not found"
+
+	// ArgTypeString, ArgTypeInt, ArgTypeBool and ArgTypeEnum are the allowed
+	// values of protofiles.ArgSpec.Type.
+	ArgTypeString = "string"
+	ArgTypeInt    = "int"
+	ArgTypeBool   = "bool"
+	ArgTypeEnum   = "enum"
+
+	// InvalidArgSpec is returned when SaveMetadata is called with a
+	// malformed Args schema.
+	InvalidArgSpec = "invalid arg spec"
+
+	// LogsDir is the etcd key prefix under which execution log lines are
+	// stored, keyed as LogsDir + "<execution-id>/" + <zero-padded seq>.
+	LogsDir = "logs/"
+)
@@ -0,0 +1,121 @@
+// Package etcd provides the thin wrapper around the etcd v3 client that the
+// rest of the server talks to, so repositories never import clientv3 directly.
+package etcd
+
+import (
+	"context"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Client is the subset of etcd operations the repositories depend on. Every
+// method applies the retry policy appropriate to its side effects; see
+// retry.go.
+type Client interface {
+	PutValue(key string, value string) error
+	// PutIfAbsent atomically creates key only if it does not already exist,
+	// using an etcd transaction instead of a separate Get-then-Put so two
+	// concurrent callers can never both believe they created the key.
+	// applied is false, with a nil error, when key was already present.
+	PutIfAbsent(key string, value string) (applied bool, err error)
+	GetValue(key string) (string, error)
+	GetAllKeyAndValues(prefixKey string) ([]string, []string, error)
+	DeleteValue(key string) error
+}
+
+type etcdClient struct {
+	cli     *clientv3.Client
+	timeout time.Duration
+}
+
+// NewClient dials etcd at the given endpoints and returns a Client.
+func NewClient(endpoints []string, dialTimeout time.Duration) (Client, error) {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &etcdClient{cli: cli, timeout: dialTimeout}, nil
+}
+
+func (c *etcdClient) PutValue(key string, value string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return withRetry(ctx, writeRetryPolicy, func() error {
+		_, err := c.cli.Put(ctx, key, value)
+		return err
+	})
+}
+
+func (c *etcdClient) PutIfAbsent(key string, value string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var applied bool
+	err := withRetry(ctx, strictRetryPolicy, func() error {
+		resp, err := c.cli.Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+			Then(clientv3.OpPut(key, value)).
+			Commit()
+		if err != nil {
+			return err
+		}
+		applied = resp.Succeeded
+		return nil
+	})
+	return applied, err
+}
+
+func (c *etcdClient) GetValue(key string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var value string
+	err := withRetry(ctx, readRetryPolicy, func() error {
+		resp, err := c.cli.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) == 0 {
+			value = ""
+			return nil
+		}
+		value = string(resp.Kvs[0].Value)
+		return nil
+	})
+	return value, err
+}
+
+func (c *etcdClient) DeleteValue(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return withRetry(ctx, writeRetryPolicy, func() error {
+		_, err := c.cli.Delete(ctx, key)
+		return err
+	})
+}
+
+func (c *etcdClient) GetAllKeyAndValues(prefixKey string) ([]string, []string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var keys, values []string
+	err := withRetry(ctx, readRetryPolicy, func() error {
+		resp, err := c.cli.Get(ctx, prefixKey, clientv3.WithPrefix())
+		if err != nil {
+			return err
+		}
+
+		keys = make([]string, 0, len(resp.Kvs))
+		values = make([]string, 0, len(resp.Kvs))
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+			values = append(values, string(kv.Value))
+		}
+		return nil
+	})
+	return keys, values, err
+}
@@ -0,0 +1,33 @@
+package etcd
+
+import "github.com/stretchr/testify/mock"
+
+// ClientMock is a testify mock implementation of Client for use in repository tests.
+type ClientMock struct {
+	mock.Mock
+}
+
+func (m *ClientMock) PutValue(key string, value string) error {
+	args := m.Called(key, value)
+	return args.Error(0)
+}
+
+func (m *ClientMock) PutIfAbsent(key string, value string) (bool, error) {
+	args := m.Called(key, value)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *ClientMock) GetValue(key string) (string, error) {
+	args := m.Called(key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *ClientMock) GetAllKeyAndValues(prefixKey string) ([]string, []string, error) {
+	args := m.Called(prefixKey)
+	return args.Get(0).([]string), args.Get(1).([]string), args.Error(2)
+}
+
+func (m *ClientMock) DeleteValue(key string) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
@@ -0,0 +1,80 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy configures the exponential backoff used when retrying a
+// retryable etcd error. It mirrors gax.Backoff: start at Initial, multiply by
+// Multiplier after every attempt, capped at Max, with the overall retry loop
+// bounded by ctx's deadline.
+type RetryPolicy struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+}
+
+// strictRetryPolicy makes a single attempt and never retries. It is used for
+// at-most-once writes where retrying a transient error risks double-applying
+// a side effect that isn't itself idempotent.
+var strictRetryPolicy = RetryPolicy{}
+
+// readRetryPolicy is used for read paths (GetValue, GetAllKeyAndValues),
+// which are safe to retry freely since they have no side effects.
+var readRetryPolicy = RetryPolicy{Initial: 100 * time.Millisecond, Max: 60 * time.Second, Multiplier: 1.3}
+
+// writeRetryPolicy is used for writes that are themselves idempotent (a
+// blind overwrite or a delete), so retrying a timed-out attempt cannot
+// change the outcome. PutIfAbsent uses strictRetryPolicy instead.
+var writeRetryPolicy = RetryPolicy{Initial: 100 * time.Millisecond, Max: 60 * time.Second, Multiplier: 1.3}
+
+// isRetryable classifies the errors etcd can return into transient ones
+// worth retrying (deadline/unavailability/leader-election churn) and
+// everything else, which is surfaced to the caller immediately.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Unavailable:
+		return true
+	}
+	return errors.Is(err, rpctypes.ErrNoLeader) ||
+		errors.Is(err, rpctypes.ErrLeaderChanged) ||
+		errors.Is(err, rpctypes.ErrTimeout) ||
+		errors.Is(err, rpctypes.ErrTimeoutDueToLeaderFail)
+}
+
+// withRetry runs op, retrying with exponential backoff per policy while the
+// error it returns is retryable and ctx has not been cancelled or exceeded
+// its deadline. A zero-value policy (strictRetryPolicy) runs op exactly once.
+func withRetry(ctx context.Context, policy RetryPolicy, op func() error) error {
+	if policy.Initial == 0 {
+		return op()
+	}
+
+	backoff := policy.Initial
+	for {
+		err := op()
+		if !isRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		backoff = time.Duration(float64(backoff) * policy.Multiplier)
+		if backoff > policy.Max {
+			backoff = policy.Max
+		}
+	}
+}
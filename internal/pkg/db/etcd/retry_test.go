@@ -0,0 +1,77 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_withRetry_RetriesRetryableErrorUntilSuccess(t *testing.T) {
+	attempts := 0
+	policy := RetryPolicy{Initial: time.Millisecond, Max: 10 * time.Millisecond, Multiplier: 1.3}
+
+	err := withRetry(context.Background(), policy, func() error {
+		attempts++
+		if attempts < 3 {
+			return status.Error(codes.Unavailable, "etcd unavailable")
+		}
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func Test_withRetry_DoesNotRetryNonRetryableError(t *testing.T) {
+	attempts := 0
+	policy := RetryPolicy{Initial: time.Millisecond, Max: 10 * time.Millisecond, Multiplier: 1.3}
+
+	err := withRetry(context.Background(), policy, func() error {
+		attempts++
+		return status.Error(codes.InvalidArgument, "bad request")
+	})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func Test_withRetry_StopsWhenContextDeadlineExceeded(t *testing.T) {
+	attempts := 0
+	policy := RetryPolicy{Initial: 5 * time.Millisecond, Max: 10 * time.Millisecond, Multiplier: 1.3}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 12*time.Millisecond)
+	defer cancel()
+
+	err := withRetry(ctx, policy, func() error {
+		attempts++
+		return status.Error(codes.Unavailable, "etcd unavailable")
+	})
+
+	assert.NotNil(t, err)
+	assert.True(t, attempts >= 1 && attempts < 10, "expected a bounded number of attempts, got %d", attempts)
+}
+
+func Test_withRetry_StrictPolicyNeverRetries(t *testing.T) {
+	attempts := 0
+
+	err := withRetry(context.Background(), strictRetryPolicy, func() error {
+		attempts++
+		return status.Error(codes.Unavailable, "etcd unavailable")
+	})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func Test_isRetryable(t *testing.T) {
+	assert.True(t, isRetryable(status.Error(codes.Unavailable, "down")))
+	assert.True(t, isRetryable(status.Error(codes.DeadlineExceeded, "timeout")))
+	assert.False(t, isRetryable(status.Error(codes.AlreadyExists, "exists")))
+	assert.False(t, isRetryable(errors.New("some unrelated error")))
+	assert.False(t, isRetryable(nil))
+}
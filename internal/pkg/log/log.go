@@ -0,0 +1,60 @@
+// Package log wraps zap to provide a single, process-wide structured logger.
+package log
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var logger *zap.SugaredLogger
+
+// Init configures the package-level logger. level is one of zap's standard
+// levels ("debug", "info", "warn", "error"); filePath, when non-empty, also
+// writes logs to disk; json switches the encoder to JSON for log shipping.
+// maxAge is accepted but not used: the log file is never rotated or pruned.
+func Init(level string, filePath string, json bool, maxAge int) {
+	zapLevel := zapcore.InfoLevel
+	_ = zapLevel.Set(level)
+
+	encoderCfg := zap.NewProductionEncoderConfig()
+	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	encoder := zapcore.NewConsoleEncoder(encoderCfg)
+	if json {
+		encoder = zapcore.NewJSONEncoder(encoderCfg)
+	}
+
+	core := zapcore.NewCore(encoder, zapcore.Lock(zapcore.AddSync(zapLoggerSink(filePath))), zapLevel)
+	logger = zap.New(core).Sugar()
+}
+
+// Info logs an informational message.
+func Info(args ...interface{}) {
+	ensureInit()
+	logger.Info(args...)
+}
+
+// Infof logs a formatted informational message.
+func Infof(template string, args ...interface{}) {
+	ensureInit()
+	logger.Infof(template, args...)
+}
+
+// Error logs err alongside a human readable message describing the context
+// in which it occurred.
+func Error(err error, msg string) {
+	ensureInit()
+	logger.Errorw(msg, "error", err)
+}
+
+// Warn logs a warning message.
+func Warn(args ...interface{}) {
+	ensureInit()
+	logger.Warn(args...)
+}
+
+func ensureInit() {
+	if logger == nil {
+		Init("info", "", false, 1)
+	}
+}
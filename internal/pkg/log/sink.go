@@ -0,0 +1,21 @@
+package log
+
+import (
+	"io"
+	"os"
+)
+
+// zapLoggerSink returns the writer logs should be sent to: stdout when no
+// file path is configured, otherwise the given file appended to (creating
+// it if necessary) in addition to stdout.
+func zapLoggerSink(filePath string) io.Writer {
+	if filePath == "" {
+		return os.Stdout
+	}
+
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return os.Stdout
+	}
+	return io.MultiWriter(os.Stdout, file)
+}